@@ -96,14 +96,24 @@
 package tinyssert
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
+	"math"
+	"net/http"
 	"os"
 	"path"
+	"path/filepath"
 	"reflect"
 	"runtime"
 	"strings"
+	"sync"
+	"time"
 	"unicode"
 	"unicode/utf8"
 )
@@ -120,6 +130,11 @@ import (
 //	 if argument > 0 {
 //		  tinyssert.OK(value, "Since %d is greater than 0, this should always be ok", argument)
 //	 }
+//
+// Structured key-value pairs created with [KV] can be passed anywhere in `msg`, they are
+// not used to fill formatting verbs and are instead attached to the [Failure]:
+//
+//	tinyssert.OK(post, "Post should be rendered", tinyssert.KV("user", id, "op", "render"))
 type Assertions interface {
 	// Asserts that the value is not zero-valued, is nil, or panics, aka. "is ok".
 	Ok(v any, msg ...any)
@@ -149,6 +164,19 @@ type Assertions interface {
 	// Asserts that the function does not panics.
 	NotPanic(fn func(), msg ...any)
 
+	// Registers or refreshes the heartbeat with the given name, expecting it to be
+	// refreshed again within the duration `d`. If it is not, a watchdog reports the
+	// failure using Fail. If the TestingT has a Cleanup method, all watchdogs are stopped
+	// when the test completes and later refreshes are ignored, otherwise StopHeartbeat should
+	// be called when the routine exits. Fails immediately if `d` is not positive.
+	Heartbeat(name string, d time.Duration, msg ...any)
+	// Stops the watchdog of the heartbeat with the given name.
+	StopHeartbeat(name string)
+
+	// Asserts that the response of the request eventually satisfies the condition, before
+	// the timeout, retrying the request each tick.
+	EventuallyHTTP(client *http.Client, req *http.Request, cond HTTPCondition, timeout, tick time.Duration, msg ...any)
+
 	// Logs the formatted failure message and/or marks the test as failed if possible,
 	// depending of what is possible to the implementation.
 	Fail(f Failure)
@@ -202,6 +230,11 @@ type AssertionsErr interface {
 	// Asserts that the function does not panics.
 	// Returns a Failure if the assertion fails, otherwise returns nil.
 	NotPanicErr(fn func(), msg ...any) Failure
+
+	// Asserts that the response of the request eventually satisfies the condition, before
+	// the timeout, retrying the request each tick.
+	// Returns a Failure if the assertion fails, otherwise returns nil.
+	EventuallyHTTPErr(client *http.Client, req *http.Request, cond HTTPCondition, timeout, tick time.Duration, msg ...any) Failure
 }
 
 // New constructs a new implementation of [Assertions]. Use `opts` to customize the behaviour
@@ -261,14 +294,52 @@ func WithLogger(l *slog.Logger) Option {
 	}
 }
 
+// WithReporter adds a [Reporter] which all failures are reported to, before being logged
+// or marking the test as failed.
+//
+// Errors returned by the reporter are logged to the [TestingT] implementation if used
+// together with [WithTest], otherwise to the logger provided by [WithLogger].
+func WithReporter(r Reporter) Option {
+	return func(a *assertions) {
+		a.reporters = append(a.reporters, r)
+	}
+}
+
+// WithInput attaches the input values to all failures of the implementation, as if they
+// were passed to the assertions using [Input].
+//
+// Useful inside the body of [testing.F.Fuzz] functions, so failing assertions halts the fuzz
+// test (similarly to [testing.T.Fatalf]) with the failing input in the failure message:
+//
+//	func FuzzParse(f *testing.F) {
+//	  f.Fuzz(func(t *testing.T, data []byte) {
+//	    assert := tinyssert.New(tinyssert.WithTest(t), tinyssert.WithPanic(), tinyssert.WithInput(data))
+//
+//	    _, err := Parse(data)
+//	    assert.Nil(err)
+//	  })
+//	}
+func WithInput(input ...any) Option {
+	return func(a *assertions) {
+		a.input = append(a.input, input...)
+	}
+}
+
 type assertions struct {
 	panic bool
 
+	reporters []Reporter
+	input     []any
+
 	test   TestingT
 	helper helperT
 
 	log   *slog.Logger
 	group string
+
+	heartbeatsMu      sync.Mutex
+	heartbeats        map[string]*time.Timer
+	heartbeatsStopped bool
 }
 
 // TestingT is a wrapper interface around [testing.T].
@@ -470,49 +541,276 @@ func (a *assertions) panics(fn func()) bool {
 	return r != nil
 }
 
+// HTTPCondition is used by [Assertions.EventuallyHTTP] to check if a response is the expected
+// one. The body of the response is already read and closed, and provided as `body`.
+type HTTPCondition = func(res *http.Response, body []byte) bool
+
+// HTTPStatus creates a [HTTPCondition] which checks if the response has the status code.
+func HTTPStatus(code int) HTTPCondition {
+	return func(res *http.Response, _ []byte) bool {
+		return res.StatusCode == code
+	}
+}
+
+// HTTPBodyContains creates a [HTTPCondition] which checks if the response body contains
+// the substring.
+func HTTPBodyContains(substr string) HTTPCondition {
+	return func(_ *http.Response, body []byte) bool {
+		return bytes.Contains(body, []byte(substr))
+	}
+}
+
+// maxHTTPBody is the maximum amount of bytes read from responses by EventuallyHTTP.
+const maxHTTPBody = 1 << 20
+
+func (a *assertions) EventuallyHTTPErr(
+	client *http.Client,
+	req *http.Request,
+	cond HTTPCondition,
+	timeout, tick time.Duration,
+	msg ...any,
+) Failure {
+	if req == nil {
+		return a.fail("expected non-nil request", msg...)
+	}
+	if cond == nil {
+		return a.fail("expected non-nil condition", msg...)
+	}
+	if timeout <= 0 || tick <= 0 {
+		return a.fail(fmt.Sprintf("expected positive timeout and tick, got %s and %s", timeout, tick), msg...)
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return a.fail("expected request with body to have GetBody set, so it can be retried", msg...)
+	}
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	var last string
+	for {
+		r := req.Clone(ctx)
+		if req.GetBody != nil {
+			b, err := req.GetBody()
+			if err != nil {
+				return a.fail(fmt.Sprintf("unable to get request body: %s", err), msg...)
+			}
+			r.Body = b
+		}
+
+		res, err := client.Do(r)
+		if err != nil {
+			// Keep the previous response if the request was just cut by the timeout
+			if ctx.Err() == nil || last == "" {
+				last = fmt.Sprintf("error: %s", err)
+			}
+		} else {
+			body, err := io.ReadAll(io.LimitReader(res.Body, maxHTTPBody))
+			_ = res.Body.Close()
+
+			if err == nil && cond(res, body) {
+				return nil
+			}
+
+			last = fmt.Sprintf("%s %q", res.Status, truncate(bytes.TrimSpace(body), 512))
+		}
+
+		select {
+		case <-ctx.Done():
+			// The request's context may have a shorter deadline than the timeout, or be canceled.
+			return a.fail(fmt.Sprintf(
+				"expected response of %s %s to satisfy condition, gave up after %s, last response: %s",
+				req.Method, req.URL, time.Since(start).Round(time.Millisecond), last,
+			), msg...)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *assertions) EventuallyHTTP(
+	client *http.Client,
+	req *http.Request,
+	cond HTTPCondition,
+	timeout, tick time.Duration,
+	msg ...any,
+) {
+	_ = a.EventuallyHTTPErr(client, req, cond, timeout, tick, msg...)
+}
+
+func truncate(b []byte, n int) string {
+	if len(b) > n {
+		return string(b[:n]) + "..."
+	}
+	return string(b)
+}
+
+func (a *assertions) Heartbeat(name string, d time.Duration, msg ...any) {
+	// Only the program counters are captured on each refresh, the failure and its caller
+	// information are built just if the heartbeat is missed.
+	pcs := callers(0)
+
+	a.heartbeatsMu.Lock()
+	defer a.heartbeatsMu.Unlock()
+
+	// Background routines can outlive the test, which is already completed.
+	if a.heartbeatsStopped {
+		return
+	}
+
+	if d <= 0 {
+		_ = a.fail(fmt.Sprintf("expected positive heartbeat duration, got %s", d), msg...)
+		return
+	}
+
+	if a.heartbeats == nil {
+		a.heartbeats = map[string]*time.Timer{}
+
+		// Watchdogs must not fail a test after it has completed, since testing.T panics if so.
+		if c, ok := a.test.(interface{ Cleanup(func()) }); ok {
+			c.Cleanup(a.stopHeartbeats)
+		}
+	}
+
+	if t, ok := a.heartbeats[name]; ok {
+		t.Stop()
+	}
+
+	// The watchdog runs on its own goroutine, so FailNow (and [testing.T.FailNow]) can't
+	// be used to halt the caller.
+	var t *time.Timer
+	t = time.AfterFunc(d, func() {
+		a.heartbeatsMu.Lock()
+		defer a.heartbeatsMu.Unlock()
+
+		// The heartbeat was refreshed or stopped while the watchdog was firing.
+		if a.heartbeatsStopped || a.heartbeats[name] != t {
+			return
+		}
+
+		reason := fmt.Sprintf("expected heartbeat %q to be refreshed within %s", name, d)
+		a.Fail(a.failureAt(reason, callerInfo(pcs), msg...))
+	})
+	a.heartbeats[name] = t
+}
+
+func (a *assertions) stopHeartbeats() {
+	a.heartbeatsMu.Lock()
+	defer a.heartbeatsMu.Unlock()
+
+	for _, t := range a.heartbeats {
+		t.Stop()
+	}
+	clear(a.heartbeats)
+	a.heartbeatsStopped = true
+}
+
+func (a *assertions) StopHeartbeat(name string) {
+	a.heartbeatsMu.Lock()
+	defer a.heartbeatsMu.Unlock()
+
+	if t, ok := a.heartbeats[name]; ok {
+		t.Stop()
+		delete(a.heartbeats, name)
+	}
+}
+
 func (a *assertions) fail(reason string, msg ...any) Failure {
 	if a.helper != nil {
 		a.helper.Helper()
 	}
 
-	f := failure{
+	f := a.failure(reason, msg...)
+
+	if a.panic {
+		a.FailNow(f)
+	} else {
+		a.Fail(f)
+	}
+
+	return f
+}
+
+func (a *assertions) failure(reason string, msg ...any) failure {
+	return a.failureAt(reason, a.CallerInfo(), msg...)
+}
+
+func (a *assertions) failureAt(reason string, callerInfo []string, msg ...any) failure {
+	args, values, input := splitMsg(msg)
+
+	return failure{
 		reason:     reason,
-		message:    fmtMessage(msg),
-		callerInfo: a.CallerInfo(),
+		message:    fmtMessage(args...),
+		values:     values,
+		input:      append(append([]any{}, a.input...), input...),
+		test:       a.testName(),
+		time:       time.Now(),
+		callerInfo: callerInfo,
 	}
+}
 
+func (a *assertions) testName() string {
 	if n, ok := a.test.(interface {
 		Name() string
 	}); ok {
-		f.test = n.Name()
+		return n.Name()
 	}
+	return ""
+}
 
-	if a.panic {
-		a.FailNow(f)
-	} else {
-		a.Fail(f)
-	}
+func (a *assertions) report(f Failure) {
+	for _, r := range a.reporters {
+		err := r.Report(f)
+		if err == nil {
+			continue
+		}
 
-	return f
+		if lt, ok := a.test.(interface {
+			Logf(format string, args ...any)
+		}); ok {
+			lt.Logf("Failed to report assertion failure: %s", err)
+		} else if a.test != nil {
+			a.test.Errorf("Failed to report assertion failure: %s", err)
+		} else {
+			a.log.Error("Failed to report assertion failure", slog.String("err", err.Error()))
+		}
+	}
 }
 
 func (a *assertions) Fail(f Failure) {
+	a.report(f)
+
 	if ft, ok := a.test.(interface {
 		Fail()
 	}); ok {
 		a.test.Errorf("ASSERTION FAILED:\n%s", f.String())
 		ft.Fail()
 	} else {
-		a.log.Error("ASSERTION FAILED",
+		args := []any{
 			slog.String("reason", f.Reason()),
 			slog.String("message", f.Message()),
 			slog.String("test", f.Test()),
 			slog.Any("caller", f.CallerInfo()),
-		)
+		}
+		for _, v := range f.Values() {
+			args = append(args, v)
+		}
+		if len(f.Input()) > 0 {
+			args = append(args, slog.Any("input", f.Input()))
+		}
+		a.log.Error("ASSERTION FAILED", args...)
 	}
 }
 
 func (a *assertions) FailNow(f Failure) {
+	a.report(f)
+
 	if ft, ok := a.test.(interface {
 		FailNow()
 	}); ok {
@@ -543,42 +841,100 @@ func fmtMessage(msg ...any) string {
 	}
 }
 
+// KeyValues are structured key-value pairs attached to a [Failure], created by [KV].
+type KeyValues []slog.Attr
+
+// KV creates a set of structured key-value pairs to be passed as part of the `msg` argument
+// of assertions. The arguments are alternating keys and values, following the same rules of
+// [slog.Logger.Log], so [slog.Attr] values can also be used directly.
+//
+// The pairs are appended to the failure and logged as [slog.Attr] when the failure is logged,
+// making failures filterable by machines instead of just being free text:
+//
+//	assert.Equal(expected, actual, "Failed to render post %q", name, tinyssert.KV("user", id, "op", "render"))
+func KV(args ...any) KeyValues {
+	return KeyValues(slog.Group("", args...).Value.Group())
+}
+
+// Inputs are the input values which caused a [Failure], created by [Input].
+type Inputs []any
+
+// Input creates a set of input values to be passed as part of the `msg` argument of
+// assertions. Similarly to [KV], they are not used to fill formatting verbs, and are
+// attached to the failure so they can be replayed, for example by [NewCorpusReporter]:
+//
+//	func Parse(data []byte) (*Document, error) {
+//	  doc, err := parse(data)
+//	  assert.True(err != nil || doc != nil, "Document should never be nil without an error", tinyssert.Input(data))
+//	  return doc, err
+//	}
+func Input(v ...any) Inputs {
+	return Inputs(v)
+}
+
+func splitMsg(msg []any) (args []any, values []slog.Attr, input []any) {
+	for _, m := range msg {
+		switch m := m.(type) {
+		case KeyValues:
+			values = append(values, m...)
+		case Inputs:
+			input = append(input, m...)
+		default:
+			args = append(args, m)
+		}
+	}
+	return args, values, input
+}
+
 func (as *assertions) CallerInfo() []string {
+	return callerInfo(callers(0))
+}
+
+// callers returns the program counters of the call stack, starting from its caller and
+// skipping `skip` frames.
+func callers(skip int) []uintptr {
+	pcs := make([]uintptr, 64)
+	for {
+		n := runtime.Callers(skip+2, pcs)
+		if n < len(pcs) {
+			return pcs[:n]
+		}
+		pcs = make([]uintptr, len(pcs)*2)
+	}
+}
+
+func callerInfo(pcs []uintptr) []string {
 	callers := []string{}
-	for i := 0; ; i++ {
-		pc, file, line, ok := runtime.Caller(i)
-		if !ok {
+	frames := runtime.CallersFrames(pcs)
+	for {
+		f, more := frames.Next()
+		if f.PC == 0 {
 			// We reached the end of the call stack
 			break
 		}
 
 		// Edge case found in https://github.com/stretchr/testify/issues/180
-		if file == "<autogenerated>" {
+		if f.File == "<autogenerated>" {
 			break
 		}
 
-		f := runtime.FuncForPC(pc)
-		if f == nil {
-			break
-		}
-		name := f.Name()
-
+		name := f.Function
 		if name == "testing.Runner" {
 			break
 		}
 
-		filename := path.Base(file)
-		dirname := path.Base(path.Dir(file))
+		filename := path.Base(f.File)
+		dirname := path.Base(path.Dir(f.File))
 		if (dirname != "assert" && dirname != "mock" && dirname != "require") ||
 			filename == "mock_test.go" {
-			callers = append(callers, fmt.Sprintf("%s:%d", file, line))
+			callers = append(callers, fmt.Sprintf("%s:%d", f.File, f.Line))
 		}
 
 		// Remove the package
 		s := strings.Split(name, ".")
 		name = s[len(s)-1]
 
-		if isTest(name, "Test") || isTest(name, "Benchmark") || isTest(name, "Example") {
+		if isTest(name, "Test") || isTest(name, "Benchmark") || isTest(name, "Example") || !more {
 			break
 		}
 	}
@@ -599,8 +955,11 @@ func isTest(name, prefix string) bool {
 type failure struct {
 	reason  string
 	message string
+	values  []slog.Attr
+	input   []any
 
 	test       string
+	time       time.Time
 	callerInfo []string
 }
 
@@ -614,11 +973,25 @@ func (e failure) Message() string {
 	return e.message
 }
 
+func (e failure) Values() []slog.Attr {
+	return e.values
+}
+
+func (e failure) Input() []any {
+	return e.input
+}
+
 func (e failure) Error() string {
+	var s string
 	if e.message != "" {
-		return fmt.Sprintf("assertion failed, %s: %s", e.reason, e.message)
+		s = fmt.Sprintf("assertion failed, %s: %s", e.reason, e.message)
+	} else {
+		s = fmt.Sprintf("assertion failed, %s", e.reason)
+	}
+	for _, v := range e.values {
+		s += " " + v.String()
 	}
-	return fmt.Sprintf("assertion failed, %s", e.reason)
+	return s
 }
 
 func (e failure) String() string {
@@ -634,6 +1007,22 @@ func (e failure) String() string {
 		c["Test"] = e.test
 	}
 
+	if len(e.values) > 0 {
+		vs := make([]string, len(e.values))
+		for i, v := range e.values {
+			vs[i] = v.String()
+		}
+		c["Values"] = strings.Join(vs, "\n")
+	}
+
+	if len(e.input) > 0 {
+		is := make([]string, len(e.input))
+		for i, v := range e.input {
+			is[i] = fmt.Sprintf("%#v", v)
+		}
+		c["Input"] = strings.Join(is, "\n")
+	}
+
 	c["Stack Trace"] = e.StackTrace()
 
 	var out string
@@ -656,6 +1045,67 @@ func (e failure) CallerInfo() []string {
 	return e.callerInfo
 }
 
+// MarshalJSON encodes the failure as a JSON object, with the reason, message, test,
+// caller frames and the time which the assertion failed. Values and inputs are
+// included if present, as strings if they can't be encoded as JSON.
+func (e failure) MarshalJSON() ([]byte, error) {
+	v := struct {
+		Reason  string         `json:"reason"`
+		Message string         `json:"message,omitempty"`
+		Test    string         `json:"test,omitempty"`
+		Values  map[string]any `json:"values,omitempty"`
+		Input   []any          `json:"input,omitempty"`
+		Caller  []string       `json:"caller"`
+		Time    time.Time      `json:"timestamp"`
+	}{
+		Reason:  e.reason,
+		Message: e.message,
+		Test:    e.test,
+		Values:  jsonValues(e.values),
+		Input:   jsonInput(e.input),
+		Caller:  e.callerInfo,
+		Time:    e.time,
+	}
+	return json.Marshal(v)
+}
+
+func jsonValues(attrs []slog.Attr) map[string]any {
+	if len(attrs) == 0 {
+		return nil
+	}
+	m := make(map[string]any, len(attrs))
+	for _, a := range attrs {
+		v := a.Value.Resolve()
+		if v.Kind() == slog.KindGroup {
+			m[a.Key] = jsonValues(v.Group())
+		} else if err, ok := v.Any().(error); ok {
+			m[a.Key] = err.Error()
+		} else if _, err := json.Marshal(v.Any()); err != nil {
+			// Values such as channels, functions and NaN can't be encoded, but should
+			// not make the whole failure be dropped.
+			m[a.Key] = v.String()
+		} else {
+			m[a.Key] = v.Any()
+		}
+	}
+	return m
+}
+
+func jsonInput(input []any) []any {
+	if len(input) == 0 {
+		return nil
+	}
+	is := make([]any, len(input))
+	for i, v := range input {
+		if _, err := json.Marshal(v); err != nil {
+			is[i] = fmt.Sprintf("%#v", v)
+		} else {
+			is[i] = v
+		}
+	}
+	return is
+}
+
 // StackTrace returns the CallerInfo strings as a formatted stack trace.
 func (e failure) StackTrace() string {
 	return strings.Join(e.callerInfo, "\n\t")
@@ -664,12 +1114,136 @@ func (e failure) StackTrace() string {
 type Failure interface {
 	Reason() string
 	Message() string
+	Values() []slog.Attr
+	Input() []any
 	Test() string
 	StackTrace() string
 	CallerInfo() []string
 
 	error
 	fmt.Stringer
+	json.Marshaler
+}
+
+// Reporter receives all failures of an [Assertions] implementation, provided via [WithReporter].
+type Reporter interface {
+	Report(f Failure) error
+}
+
+// NewCorpusReporter creates a [Reporter] which records the inputs of failures (see [Input]
+// and [WithInput]) as seed corpus files of the Go fuzzing engine in the directory `dir`.
+// Failures without inputs are ignored.
+//
+// Useful for replaying invariant violations found while running with assertions enabled,
+// by pointing `dir` to the package's "testdata/fuzz/FuzzXxx" directory:
+//
+//	assert := tinyssert.New(tinyssert.WithReporter(tinyssert.NewCorpusReporter("testdata/fuzz/FuzzParse")))
+//
+// Only the types supported by the fuzzing engine can be recorded: []byte, string, bool,
+// and all integer and floating-point types.
+func NewCorpusReporter(dir string) Reporter {
+	return &corpusReporter{dir: dir}
+}
+
+type corpusReporter struct {
+	dir string
+}
+
+func (r *corpusReporter) Report(f Failure) error {
+	if len(f.Input()) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteString("go test fuzz v1\n")
+	for _, v := range f.Input() {
+		l, err := fmtCorpusValue(v)
+		if err != nil {
+			return err
+		}
+		b.WriteString(l + "\n")
+	}
+
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return err
+	}
+
+	// Same naming as the Go fuzzing engine uses
+	sum := sha256.Sum256([]byte(b.String()))
+	name := hex.EncodeToString(sum[:])[:16]
+
+	return os.WriteFile(filepath.Join(r.dir, name), []byte(b.String()), 0o644)
+}
+
+// NewJSONReporter creates a [Reporter] which writes each failure as a JSON record (see
+// [Failure.MarshalJSON]) followed by a newline to `w`, producing newline-delimited JSON
+// that can be aggregated by CI systems without parsing log text:
+//
+//	f, _ := os.Create("failures.ndjson")
+//	assert := tinyssert.New(tinyssert.WithReporter(tinyssert.NewJSONReporter(f)))
+//
+// Writes are serialized, so the reporter is safe to be used concurrently.
+func NewJSONReporter(w io.Writer) Reporter {
+	return &jsonReporter{w: w}
+}
+
+type jsonReporter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (r *jsonReporter) Report(f Failure) error {
+	b, merr := json.Marshal(f)
+	if merr != nil {
+		// Custom Failure implementations may not be encodable, the record is still
+		// written so the failure is not lost.
+		b, _ = json.Marshal(struct {
+			Reason  string   `json:"reason"`
+			Message string   `json:"message,omitempty"`
+			Test    string   `json:"test,omitempty"`
+			Caller  []string `json:"caller"`
+			Error   string   `json:"error"`
+		}{
+			Reason:  f.Reason(),
+			Message: f.Message(),
+			Test:    f.Test(),
+			Caller:  f.CallerInfo(),
+			Error:   merr.Error(),
+		})
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.w.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	return merr
+}
+
+func fmtCorpusValue(v any) (string, error) {
+	switch v := v.(type) {
+	case []byte:
+		return fmt.Sprintf("[]byte(%q)", v), nil
+	case string:
+		return fmt.Sprintf("string(%q)", v), nil
+	case bool:
+		return fmt.Sprintf("bool(%t)", v), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%T(%d)", v, v), nil
+	case float32:
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return fmt.Sprintf("math.Float32frombits(0x%x)", math.Float32bits(v)), nil
+		}
+		return fmt.Sprintf("float32(%v)", v), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Sprintf("math.Float64frombits(0x%x)", math.Float64bits(v)), nil
+		}
+		return fmt.Sprintf("float64(%v)", v), nil
+	default:
+		return "", fmt.Errorf("unsupported fuzzing input type %T", v)
+	}
 }
 
 type disabledAssertions struct{}
@@ -686,31 +1260,44 @@ func NewDisabled(opts ...Option) AssertionsErr {
 	return &disabledAssertions{}
 }
 
-func (*disabledAssertions) Ok(any, ...any)                         {}
-func (*disabledAssertions) Equal(_, _ any, _ ...any)               {}
-func (*disabledAssertions) NotEqual(_, _ any, _ ...any)            {}
-func (*disabledAssertions) Nil(any, ...any)                        {}
-func (*disabledAssertions) NotNil(any, ...any)                     {}
-func (*disabledAssertions) True(bool, ...any)                      {}
-func (*disabledAssertions) False(bool, ...any)                     {}
-func (*disabledAssertions) Zero(any, ...any)                       {}
-func (*disabledAssertions) NotZero(any, ...any)                    {}
-func (*disabledAssertions) Panic(func(), ...any)                   {}
-func (*disabledAssertions) NotPanic(func(), ...any)                {}
-func (*disabledAssertions) OkErr(any, ...any) Failure              { return nil }
-func (*disabledAssertions) EqualErr(_, _ any, _ ...any) Failure    { return nil }
-func (*disabledAssertions) NotEqualErr(_, _ any, _ ...any) Failure { return nil }
-func (*disabledAssertions) NilErr(any, ...any) Failure             { return nil }
-func (*disabledAssertions) NotNilErr(any, ...any) Failure          { return nil }
-func (*disabledAssertions) TrueErr(bool, ...any) Failure           { return nil }
-func (*disabledAssertions) FalseErr(bool, ...any) Failure          { return nil }
-func (*disabledAssertions) ZeroErr(any, ...any) Failure            { return nil }
-func (*disabledAssertions) NotZeroErr(any, ...any) Failure         { return nil }
-func (*disabledAssertions) PanicErr(func(), ...any) Failure        { return nil }
-func (*disabledAssertions) NotPanicErr(func(), ...any) Failure     { return nil }
-func (*disabledAssertions) Fail(f Failure)                         { Default.Fail(f) }
-func (*disabledAssertions) FailNow(f Failure)                      { Default.FailNow(f) }
-func (*disabledAssertions) CallerInfo() []string                   { return Default.CallerInfo() }
+func (*disabledAssertions) Ok(any, ...any)                          {}
+func (*disabledAssertions) Equal(_, _ any, _ ...any)                {}
+func (*disabledAssertions) NotEqual(_, _ any, _ ...any)             {}
+func (*disabledAssertions) Nil(any, ...any)                         {}
+func (*disabledAssertions) NotNil(any, ...any)                      {}
+func (*disabledAssertions) True(bool, ...any)                       {}
+func (*disabledAssertions) False(bool, ...any)                      {}
+func (*disabledAssertions) Zero(any, ...any)                        {}
+func (*disabledAssertions) NotZero(any, ...any)                     {}
+func (*disabledAssertions) Panic(func(), ...any)                    {}
+func (*disabledAssertions) NotPanic(func(), ...any)                 {}
+func (*disabledAssertions) Heartbeat(string, time.Duration, ...any) {}
+func (*disabledAssertions) StopHeartbeat(string)                    {}
+func (*disabledAssertions) OkErr(any, ...any) Failure               { return nil }
+func (*disabledAssertions) EqualErr(_, _ any, _ ...any) Failure     { return nil }
+func (*disabledAssertions) NotEqualErr(_, _ any, _ ...any) Failure  { return nil }
+func (*disabledAssertions) NilErr(any, ...any) Failure              { return nil }
+func (*disabledAssertions) NotNilErr(any, ...any) Failure           { return nil }
+func (*disabledAssertions) TrueErr(bool, ...any) Failure            { return nil }
+func (*disabledAssertions) FalseErr(bool, ...any) Failure           { return nil }
+func (*disabledAssertions) ZeroErr(any, ...any) Failure             { return nil }
+func (*disabledAssertions) NotZeroErr(any, ...any) Failure          { return nil }
+func (*disabledAssertions) PanicErr(func(), ...any) Failure         { return nil }
+func (*disabledAssertions) NotPanicErr(func(), ...any) Failure      { return nil }
+func (*disabledAssertions) Fail(f Failure)                          { Default.Fail(f) }
+func (*disabledAssertions) FailNow(f Failure)                       { Default.FailNow(f) }
+func (*disabledAssertions) CallerInfo() []string                    { return Default.CallerInfo() }
+
+func (*disabledAssertions) EventuallyHTTP(
+	*http.Client, *http.Request, HTTPCondition, time.Duration, time.Duration, ...any,
+) {
+}
+
+func (*disabledAssertions) EventuallyHTTPErr(
+	*http.Client, *http.Request, HTTPCondition, time.Duration, time.Duration, ...any,
+) Failure {
+	return nil
+}
 
 var (
 	// DefaultLogger is the default [slog.Logger] used by [Default]
@@ -885,6 +1472,77 @@ func NotPanicErr(fn func(), msg ...any) Failure {
 	return Default.NotPanicErr(fn, msg...)
 }
 
+// Heartbeat registers or refreshes the heartbeat with the given name, expecting it to be
+// refreshed again within the duration `d`. If it is not, a watchdog reports the failure
+// using [Fail]. Useful for asserting that background routines are still running:
+//
+//	for {
+//	  tinyssert.Heartbeat("cache-janitor", 2*interval)
+//	  cache.Clean()
+//	  time.Sleep(interval)
+//	}
+//
+// The watchdog runs on its own goroutine, so it never panics or halts the program, even if
+// the implementation was created using [WithPanic]. Use [StopHeartbeat] to stop the watchdog
+// when the routine exits, otherwise the failure is reported after it stops refreshing. On
+// implementations created using [WithTest], watchdogs are also stopped once the test completes,
+// and refreshes from routines which outlive the test are ignored. The assertion fails
+// immediately if `d` is not positive.
+//
+// Each refresh only captures the caller's program counters, the caller information of the
+// failure is resolved just if the heartbeat is missed.
+//
+// Logs the failure message with [DefaultLogger].
+func Heartbeat(name string, d time.Duration, msg ...any) {
+	Default.Heartbeat(name, d, msg...)
+}
+
+// StopHeartbeat stops the watchdog of the heartbeat with the given name, registered by
+// [Heartbeat]. Does nothing if there is no heartbeat with the name.
+func StopHeartbeat(name string) {
+	Default.StopHeartbeat(name)
+}
+
+// EventuallyHTTP asserts that the response of the request eventually satisfies the condition,
+// performing the request each tick until the timeout (or the request's context deadline) is
+// reached. If `client` is nil, [http.DefaultClient] is used. The failure message includes the
+// last response received:
+//
+//	req, _ := http.NewRequest(http.MethodGet, server.URL+"/healthz", nil)
+//	tinyssert.EventuallyHTTP(nil, req, tinyssert.HTTPStatus(http.StatusOK), 5*time.Second, 100*time.Millisecond)
+//
+// Requests with a body must have [http.Request.GetBody] set (as [http.NewRequest] does for
+// common body types), so it can be re-sent on each retry, otherwise the assertion fails
+// without performing the request. The assertion also fails if `req` or `cond` are nil, or
+// if `timeout` or `tick` are not positive.
+//
+// Logs the failure message with [DefaultLogger].
+func EventuallyHTTP(
+	client *http.Client,
+	req *http.Request,
+	cond HTTPCondition,
+	timeout, tick time.Duration,
+	msg ...any,
+) {
+	Default.EventuallyHTTP(client, req, cond, timeout, tick, msg...)
+}
+
+// EventuallyHTTPErr asserts that the response of the request eventually satisfies the condition,
+// performing the request each tick until the timeout (or the request's context deadline) is
+// reached. See [EventuallyHTTP] for more information.
+// Returns a Failure if the assertion fails, otherwise returns nil.
+//
+// Logs the failure message with [DefaultLogger].
+func EventuallyHTTPErr(
+	client *http.Client,
+	req *http.Request,
+	cond HTTPCondition,
+	timeout, tick time.Duration,
+	msg ...any,
+) Failure {
+	return Default.EventuallyHTTPErr(client, req, cond, timeout, tick, msg...)
+}
+
 // Fail logs the formatted failure message using [DefaultLogger].
 func Fail(f Failure) {
 	Default.Fail(f)
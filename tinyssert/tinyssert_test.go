@@ -0,0 +1,135 @@
+package tinyssert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type recordReporter struct {
+	failures chan Failure
+}
+
+func (r *recordReporter) Report(f Failure) error {
+	r.failures <- f
+	return nil
+}
+
+type errReporter struct{}
+
+func (errReporter) Report(Failure) error {
+	return errors.New("unsupported fuzzing input type")
+}
+
+type logT struct {
+	logs []string
+}
+
+func (t *logT) Errorf(format string, args ...any) {}
+
+func (t *logT) Logf(format string, args ...any) {
+	t.logs = append(t.logs, fmt.Sprintf(format, args...))
+}
+
+func TestReporterErrorLoggedToTest(t *testing.T) {
+	lt := &logT{}
+	a := New(WithTest(lt), WithReporter(errReporter{}))
+
+	a.Equal(1, 2)
+
+	if len(lt.logs) != 1 || !strings.Contains(lt.logs[0], "unsupported fuzzing input type") {
+		t.Errorf("expected reporter error to be logged to the test, got %q", lt.logs)
+	}
+}
+
+func TestHeartbeatMissed(t *testing.T) {
+	r := &recordReporter{failures: make(chan Failure, 1)}
+	a := New(WithReporter(r))
+	defer a.StopHeartbeat("janitor")
+
+	a.Heartbeat("janitor", 5*time.Millisecond)
+
+	select {
+	case f := <-r.failures:
+		if len(f.CallerInfo()) == 0 {
+			t.Error("expected missed heartbeat failure to have caller information")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected missed heartbeat to be reported")
+	}
+}
+
+func TestHeartbeatNonPositiveDuration(t *testing.T) {
+	r := &recordReporter{failures: make(chan Failure, 1)}
+	a := New(WithReporter(r))
+
+	a.Heartbeat("janitor", 0)
+
+	select {
+	case <-r.failures:
+	default:
+		t.Fatal("expected non-positive duration to fail immediately")
+	}
+}
+
+// Refreshes from routines which outlive the test must not re-arm the watchdog, since
+// failing a completed test makes the test binary panic.
+func TestHeartbeatAfterTestCompleted(t *testing.T) {
+	var a Assertions
+	t.Run("janitor", func(t *testing.T) {
+		a = New(WithTest(t))
+		a.Heartbeat("janitor", 5*time.Millisecond)
+	})
+
+	a.Heartbeat("janitor", 5*time.Millisecond)
+	a.Heartbeat("other", 5*time.Millisecond)
+
+	time.Sleep(50 * time.Millisecond)
+}
+
+func TestEventuallyHTTPInvalidArguments(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer srv.Close()
+
+	a := New()
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+
+	if f := a.EventuallyHTTPErr(nil, nil, HTTPStatus(http.StatusOK), time.Second, time.Millisecond); f == nil {
+		t.Error("expected nil request to fail")
+	}
+	if f := a.EventuallyHTTPErr(nil, req, nil, time.Second, time.Millisecond); f == nil {
+		t.Error("expected nil condition to fail")
+	}
+	if f := a.EventuallyHTTPErr(nil, req, HTTPStatus(http.StatusOK), time.Second, 0); f == nil {
+		t.Error("expected non-positive tick to fail")
+	}
+}
+
+func TestEventuallyHTTPRequestDeadline(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	a := New()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+
+	f := a.EventuallyHTTPErr(nil, req, HTTPStatus(http.StatusOK), time.Minute, 10*time.Millisecond)
+	if f == nil {
+		t.Fatal("expected request to fail")
+	}
+	if strings.Contains(f.Reason(), time.Minute.String()) {
+		t.Errorf("expected failure to not report the timeout argument, got %q", f.Reason())
+	}
+}